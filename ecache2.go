@@ -2,6 +2,8 @@ package ecache2
 
 import (
 	"encoding/binary"
+	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -79,40 +81,162 @@ type node[K comparable] struct {
 	k        K
 	v        value
 	expireAt int64 // nano timestamp, expireAt=0 if marked as deleted, `createdAt`=`expireAt`-`expiration`
+	visited  uint8 // SIEVE visited bit, unused in classic LRU mode
 }
 
 type cache[K comparable] struct {
-	dlnk [][2]uint16  // double link list, 0 for prev, 1 for next, the first node stands for [tail, head]
-	m    []node[K]    // memory pre-allocated
-	hmap map[K]uint16 // key -> idx in []node
-	last uint16       // last element index when not full
+	dlnk      [][2]uint16  // double link list, 0 for prev, 1 for next, the first node stands for [tail, head]
+	m         []node[K]    // memory pre-allocated, or grown on demand in byte-bounded mode
+	hmap      map[K]uint16 // key -> idx in []node
+	last      uint16       // last element index when not full
+	sieve     bool         // SIEVE eviction instead of classic LRU
+	hand      uint16       // SIEVE hand, 0 until the first eviction picks a starting point
+	bytesCap  uint64       // per-bucket byte budget, 0 means classic count-bounded mode
+	bytesUsed uint64       // current accumulated entry size when bytesCap > 0
+	free      []uint16     // free-list of reclaimed slots when bytesCap > 0 (m/dlnk grow by append otherwise)
 }
 
-func create[K comparable](cap uint32) *cache[K] {
+func create[K comparable](cap uint32, sieve bool) *cache[K] {
 	return &cache[K]{
-		make([][2]uint16, cap+1),
-		make([]node[K], cap),
-		make(map[K]uint16, cap),
-		0,
+		dlnk:  make([][2]uint16, cap+1),
+		m:     make([]node[K], cap),
+		hmap:  make(map[K]uint16, cap),
+		sieve: sieve,
+	}
+}
+
+// createBytes makes a bucket bounded by accumulated entry size rather
+// than item count: `m`/`dlnk` grow by append as needed (bounded by
+// bytesCap and by the uint16 slot index, see putBytes) and evicted slots
+// are returned to a free-list for reuse.
+func createBytes[K comparable](bytesCap uint64) *cache[K] {
+	return &cache[K]{
+		dlnk:     make([][2]uint16, 1),
+		hmap:     make(map[K]uint16),
+		bytesCap: bytesCap,
+	}
+}
+
+// entrySize approximates the memory an entry occupies in a byte-bounded
+// bucket: the raw payload plus a fixed overhead for the key and
+// bookkeeping (node slot, dlnk slot, hmap entry).
+const entryOverhead = 64
+
+func entrySize(b []byte) uint64 { return uint64(len(b)) + entryOverhead }
+
+// unlink fully removes the node at idx from the doubly-linked list; used
+// in byte-bounded buckets, where evicted slots go to the free-list
+// instead of being opportunistically reused in place like classic mode.
+func (c *cache[K]) unlink(idx uint16) {
+	pv, nx := c.dlnk[idx][p], c.dlnk[idx][n]
+	c.dlnk[pv][n], c.dlnk[nx][p] = nx, pv
+}
+
+// evictToFit evicts from the tail until admitting `extra` more bytes
+// would not exceed bytesCap, notifying `on` for each eviction.
+func (c *cache[K]) evictToFit(extra uint64, on Inspector[K]) {
+	for c.bytesUsed+extra > c.bytesCap && c.last > 0 {
+		victim := c.dlnk[0][p]
+		nd := &c.m[victim-1]
+		if nd.expireAt > 0 {
+			on(PUT, nd.k, nd.v.i, nd.v.b, -1)
+		}
+		c.bytesUsed -= entrySize(nd.v.b)
+		delete(c.hmap, nd.k)
+		c.unlink(victim)
+		nd.v.i, nd.v.b, nd.expireAt = nil, nil, 0
+		c.free = append(c.free, victim)
+		c.last--
+	}
+}
+
+// putBytes is the put path for byte-bounded buckets (bytesCap > 0):
+// entries larger than the whole budget are rejected rather than
+// admitted, and otherwise the tail is evicted until the new entry fits.
+func (c *cache[K]) putBytes(k K, i *interface{}, b []byte, expireAt int64, on Inspector[K]) int {
+	sz := entrySize(b)
+	if sz > c.bytesCap {
+		return 0 // entry alone exceeds the bucket budget, reject rather than split (and rather than evicting siblings to make room for it)
+	}
+	if x, ok := c.hmap[k]; ok {
+		c.bytesUsed += sz - entrySize(c.m[x-1].v.b)
+		c.m[x-1].v.i, c.m[x-1].v.b, c.m[x-1].expireAt = i, b, expireAt
+		c.adjust(x, p, n)
+		c.evictToFit(0, on)
+		return 0
+	}
+	c.evictToFit(sz, on)
+
+	var idx uint16
+	if l := len(c.free); l > 0 {
+		idx, c.free = c.free[l-1], c.free[:l-1]
+	} else {
+		if len(c.m) >= math.MaxUint16 { // one more slot would wrap the uint16 index and alias an existing entry
+			return 0 // reject rather than corrupt: the byte budget alone doesn't bound slot count for small entries
+		}
+		c.m = append(c.m, node[K]{})
+		c.dlnk = append(c.dlnk, [2]uint16{})
+		idx = uint16(len(c.m))
 	}
+	c.m[idx-1].k, c.m[idx-1].v.i, c.m[idx-1].v.b, c.m[idx-1].expireAt = k, i, b, expireAt
+	if c.last == 0 {
+		c.dlnk[0][p] = idx
+	} else {
+		c.dlnk[c.dlnk[0][n]][p] = idx
+	}
+	c.dlnk[idx], c.hmap[k], c.dlnk[0][n] = [2]uint16{0, c.dlnk[0][n]}, idx, idx
+	c.last++
+	c.bytesUsed += sz
+	return 1
+}
+
+// evict picks a SIEVE victim: starting from the hand, nodes with the
+// visited bit set are pardoned (bit cleared, hand advances toward head,
+// wrapping back to the tail past the head), the first unvisited node is
+// evicted and the hand rewinds to its predecessor so the next eviction
+// resumes from there.
+func (c *cache[K]) evict() uint16 {
+	if c.hand == 0 {
+		c.hand = c.dlnk[0][p] // start at the tail
+	}
+	for c.m[c.hand-1].visited == 1 {
+		c.m[c.hand-1].visited = 0
+		if c.hand = c.dlnk[c.hand][p]; c.hand == 0 { // past the head, wrap to tail
+			c.hand = c.dlnk[0][p]
+		}
+	}
+	victim := c.hand
+	c.hand = c.dlnk[victim][p] // rewind toward the head so the sweep stays monotonic
+	return victim
 }
 
 // put a cache item into lru cache, if added return 1, updated return 0
-func (c *cache[K]) put(k K, i *interface{}, b []byte, expireAt int64, on inspector[K]) int {
+func (c *cache[K]) put(k K, i *interface{}, b []byte, expireAt int64, on Inspector[K]) int {
+	if c.bytesCap > 0 {
+		return c.putBytes(k, i, b, expireAt, on)
+	}
 	if x, ok := c.hmap[k]; ok {
 		c.m[x-1].v.i, c.m[x-1].v.b, c.m[x-1].expireAt = i, b, expireAt
-		c.adjust(x, p, n) // refresh to head
+		if c.sieve {
+			c.m[x-1].visited = 1
+		} else {
+			c.adjust(x, p, n) // refresh to head
+		}
 		return 0
 	}
 
 	if c.last == uint16(cap(c.m)) {
-		tail := &c.m[c.dlnk[0][p]-1]
+		victim := c.dlnk[0][p]
+		if c.sieve {
+			victim = c.evict()
+		}
+		tail := &c.m[victim-1]
 		if (*tail).expireAt > 0 { // do not notify for mark delete ones
 			on(PUT, (*tail).k, (*tail).v.i, (*tail).v.b, -1)
 		}
 		delete(c.hmap, (*tail).k)
-		c.hmap[k], (*tail).k, (*tail).v.i, (*tail).v.b, (*tail).expireAt = c.dlnk[0][p], k, i, b, expireAt // reuse to reduce gc
-		c.adjust(c.dlnk[0][p], p, n)                                                                       // refresh to head
+		c.hmap[k], (*tail).k, (*tail).v.i, (*tail).v.b, (*tail).expireAt, (*tail).visited = victim, k, i, b, expireAt, 0 // reuse to reduce gc
+		c.adjust(victim, p, n)                                                                                           // new inserts go at the head, sieve included: sieve reads never move nodes, so its list stays in insertion order
 		return 1
 	}
 
@@ -129,7 +253,19 @@ func (c *cache[K]) put(k K, i *interface{}, b []byte, expireAt int64, on inspect
 // get value of key from lru cache with result
 func (c *cache[K]) get(k K) (*node[K], int) {
 	if x, ok := c.hmap[k]; ok {
-		c.adjust(x, p, n) // refresh to head
+		if c.sieve {
+			c.m[x-1].visited = 1 // mark visited, no list reordering on read
+		} else {
+			c.adjust(x, p, n) // refresh to head
+		}
+		return &c.m[x-1], 1
+	}
+	return nil, 0
+}
+
+// peek value of key from lru cache without refreshing its recency
+func (c *cache[K]) peek(k K) (*node[K], int) {
+	if x, ok := c.hmap[k]; ok {
 		return &c.m[x-1], 1
 	}
 	return nil, 0
@@ -138,6 +274,15 @@ func (c *cache[K]) get(k K) (*node[K], int) {
 // delete item by key from lru cache
 func (c *cache[K]) del(k K) (_ *node[K], _ int, e int64) {
 	if x, ok := c.hmap[k]; ok && c.m[x-1].expireAt > 0 {
+		if c.bytesCap > 0 { // reclaim the slot and its bytes immediately, no fixed ring to opportunistically reuse
+			c.bytesUsed -= entrySize(c.m[x-1].v.b)
+			delete(c.hmap, k)
+			c.unlink(x)
+			e, c.m[x-1].expireAt = c.m[x-1].expireAt, 0
+			c.free = append(c.free, x)
+			c.last--
+			return &c.m[x-1], 1, e
+		}
 		c.m[x-1].expireAt, e = 0, c.m[x-1].expireAt // mark as deleted
 		c.adjust(x, n, p)                           // sink to tail
 		return &c.m[x-1], 1, e
@@ -154,6 +299,59 @@ func (c *cache[K]) walk(walker func(key K, iface *interface{}, bytes []byte, exp
 	}
 }
 
+// Put implements Cacher.
+func (c *cache[K]) Put(k K, i *interface{}, b []byte, expireAt int64, on Inspector[K]) int {
+	return c.put(k, i, b, expireAt, on)
+}
+
+// Get implements Cacher.
+func (c *cache[K]) Get(k K, enforceTTL bool, refreshTo int64) (i *interface{}, b []byte, ok bool) {
+	n, s := c.get(k)
+	if s <= 0 || n.expireAt <= 0 || (enforceTTL && now() >= n.expireAt) {
+		return nil, nil, false
+	}
+	n.expireAt = refreshTo // no need to remove the expired item here, otherwise will cause GC thrashing
+	return n.v.i, n.v.b, true
+}
+
+// Peek implements Cacher.
+func (c *cache[K]) Peek(k K, enforceTTL bool) (i *interface{}, b []byte, ok bool) {
+	n, s := c.peek(k)
+	if s <= 0 || n.expireAt <= 0 || (enforceTTL && now() >= n.expireAt) {
+		return nil, nil, false
+	}
+	return n.v.i, n.v.b, true
+}
+
+// Del implements Cacher.
+func (c *cache[K]) Del(k K) (i *interface{}, b []byte, expireAt int64, ok bool) {
+	n, s, e := c.del(k)
+	if s <= 0 {
+		return nil, nil, 0, false
+	}
+	i, b = n.v.i, n.v.b
+	n.v.i, n.v.b = nil, nil // release references now, don't wait for slot reuse
+	return i, b, e, true
+}
+
+// Walk implements Cacher.
+func (c *cache[K]) Walk(walker func(key K, iface *interface{}, bytes []byte, expireAt int64) bool) {
+	c.walk(walker)
+}
+
+// Len implements Cacher.
+func (c *cache[K]) Len() int { return len(c.hmap) }
+
+// Cap implements Cacher.
+func (c *cache[K]) Cap() int { return cap(c.m) }
+
+// Expired implements expirer: k is present but past its TTL, not yet
+// reclaimed (expired items are evicted lazily, see NewLRUCache).
+func (c *cache[K]) Expired(k K, now int64) bool {
+	x, ok := c.hmap[k]
+	return ok && c.m[x-1].expireAt > 0 && now >= c.m[x-1].expireAt
+}
+
 // when f=0, t=1, move to head, otherwise to tail
 func (c *cache[K]) adjust(idx, f, t uint16) {
 	if c.dlnk[idx][f] != 0 { // f=0, t=1, not head node, otherwise not tail
@@ -161,7 +359,9 @@ func (c *cache[K]) adjust(idx, f, t uint16) {
 	}
 }
 
-type inspector[K comparable] func(action int, key K, iface *interface{}, bytes []byte, status int)
+// Inspector is the callback shape used to observe cache actions; see
+// `Cache.Inspect`.
+type Inspector[K comparable] func(action int, key K, iface *interface{}, bytes []byte, status int)
 
 const (
 	PUT = iota + 1
@@ -169,15 +369,66 @@ const (
 	DEL
 )
 
+// Cacher is the eviction-policy abstraction behind each shard of Cache.
+// Put/Get/Peek/Del/Walk/Len/Cap operate on a single bucket; `create` and
+// `createBytes` are the built-in LRU/SIEVE/byte-bounded implementations.
+// Third parties can implement their own and register a factory with
+// RegisterPolicy for use with NewCacheWithPolicy.
+type Cacher[K comparable] interface {
+	// Put inserts or updates k, evicting via `on` as needed; returns 1 if added, 0 if updated or rejected
+	Put(k K, i *interface{}, b []byte, expireAt int64, on Inspector[K]) int
+	// Get looks up k; if found and not expired (when enforceTTL), its expiry is refreshed to refreshTo
+	Get(k K, enforceTTL bool, refreshTo int64) (i *interface{}, b []byte, ok bool)
+	// Peek looks up k like Get, without refreshing its expiry or recency
+	Peek(k K, enforceTTL bool) (i *interface{}, b []byte, ok bool)
+	// Del marks k deleted, returning its last value and expiry
+	Del(k K) (i *interface{}, b []byte, expireAt int64, ok bool)
+	// Walk calls walker for each valid item, stopping early if it returns false
+	Walk(walker func(key K, iface *interface{}, bytes []byte, expireAt int64) bool)
+	// Len reports the current live item count
+	Len() int
+	// Cap reports the bucket's item capacity (approximate for byte-bounded buckets)
+	Cap() int
+}
+
+// expirer is an optional capability a Cacher[K] can implement to let
+// Cache[K] distinguish a true miss from a lazily-expired entry for
+// Stats(); built-in policies implement it via `*cache[K]`, third-party
+// ones fall back to counting every miss as a plain miss.
+type expirer[K comparable] interface {
+	Expired(k K, now int64) bool
+}
+
 // Cache - generic concurrent cache structure
 // For string keys: uses BKRD hash for sharding
 // For integer keys: uses key value directly for sharding (no hash calculation)
 type Cache[K Hashable] struct {
 	locks      []sync.Mutex
-	insts      [][2]*cache[K] // level-0 for normal LRU, level-1 for LRU-2
+	insts      [][2]Cacher[K] // level-0 for normal LRU, level-1 for LRU-2
 	expiration time.Duration
-	on         inspector[K]
+	on         Inspector[K]
 	mask       int32
+	stats      metrics          // lock-free counters backing Stats()
+	collector  MetricsCollector // optional external sink (Prometheus/OTel); nil by default
+}
+
+// newCacheShell allocates the shard plumbing shared by all Cache
+// constructors; callers still need to fill in `insts[i][0]`.
+func newCacheShell[K Hashable](bucketCnt uint16, expiration ...time.Duration) *Cache[K] {
+	mask := maskOfNextPowOf2(bucketCnt)
+	c := &Cache[K]{
+		make([]sync.Mutex, mask+1),
+		make([][2]Cacher[K], mask+1),
+		0,
+		func(int, K, *interface{}, []byte, int) {},
+		int32(mask),
+		metrics{},
+		nil,
+	}
+	if len(expiration) > 0 {
+		c.expiration = expiration[0]
+	}
+	return c
 }
 
 // NewLRUCache - create generic lru cache
@@ -187,28 +438,150 @@ type Cache[K Hashable] struct {
 // For string keys: uses BKRD hash for sharding
 // For integer keys: uses key value directly for sharding (no hash calculation)
 func NewLRUCache[K Hashable](bucketCnt, capPerBkt uint16, expiration ...time.Duration) *Cache[K] {
-	mask := maskOfNextPowOf2(bucketCnt)
-	c := &Cache[K]{
-		make([]sync.Mutex, mask+1),
-		make([][2]*cache[K], mask+1),
-		0,
-		func(int, K, *interface{}, []byte, int) {},
-		int32(mask),
+	c := newCacheShell[K](bucketCnt, expiration...)
+	for i := range c.insts {
+		c.insts[i][0] = create[K](uint32(capPerBkt), false)
 	}
+	return c
+}
+
+// NewSieveCache - create generic cache using the SIEVE eviction algorithm
+// instead of classic LRU: `Get` only flips a visited bit and never touches
+// the doubly-linked list, which removes list-shuffling from the read path
+// under `c.locks[idx]`; eviction sweeps a hand pointer that pardons visited
+// nodes (clearing the bit) until it finds one to reclaim. Parameters are
+// the same as `NewLRUCache`.
+func NewSieveCache[K Hashable](bucketCnt, capPerBkt uint16, expiration ...time.Duration) *Cache[K] {
+	c := newCacheShell[K](bucketCnt, expiration...)
 	for i := range c.insts {
-		c.insts[i][0] = create[K](uint32(capPerBkt))
+		c.insts[i][0] = create[K](uint32(capPerBkt), true)
 	}
-	if len(expiration) > 0 {
-		c.expiration = expiration[0]
+	return c
+}
+
+// NewLRUCacheBytes - create generic cache bounded by bytes rather than item
+// count, for workloads (rendered pages, DNS answers, HTTP bodies) where a
+// fixed item count is a poor proxy for RAM. `bytesPerBkt` is the memory
+// budget per bucket, charged per entry as its payload (see `PutBytes`) plus
+// a fixed overhead (see `entrySize`); entries whose own size exceeds the
+// budget are rejected rather than admitted.
+func NewLRUCacheBytes[K Hashable](bucketCnt uint16, bytesPerBkt uint64, expiration ...time.Duration) *Cache[K] {
+	c := newCacheShell[K](bucketCnt, expiration...)
+	for i := range c.insts {
+		c.insts[i][0] = createBytes[K](bytesPerBkt)
+	}
+	return c
+}
+
+var policies sync.Map // "Type:name" -> func(cap uint32) Cacher[K], keyed per K so callers can reuse names across key types
+
+func policyKey[K comparable](name string) string {
+	var zero K
+	return fmt.Sprintf("%T:%s", zero, name)
+}
+
+// RegisterPolicy makes a custom Cacher[K] factory available to
+// NewCacheWithPolicy under name, for the same key type K it was
+// registered with.
+func RegisterPolicy[K comparable](name string, f func(cap uint32) Cacher[K]) {
+	policies.Store(policyKey[K](name), f)
+}
+
+func builtinPolicy[K Hashable](policy string) func(cap uint32) Cacher[K] {
+	switch policy {
+	case "", "lru":
+		return func(cap uint32) Cacher[K] { return create[K](cap, false) }
+	case "sieve":
+		return func(cap uint32) Cacher[K] { return create[K](cap, true) }
+	case "tinylfu":
+		return func(cap uint32) Cacher[K] { return newTinyLFU[K](cap) }
+	}
+	return nil
+}
+
+// NewCacheWithPolicy - create a cache using a named eviction policy
+// instead of a dedicated constructor. Built in: "lru" (default, same as
+// NewLRUCache), "sieve" (same as NewSieveCache), "tinylfu" (frequency-
+// sketch admission in front of LRU). LRU-2 remains available on top of
+// any policy via the `LRU2` chain call. Other names must be registered
+// first via RegisterPolicy, for this same K.
+func NewCacheWithPolicy[K Hashable](policy string, bucketCnt, capPerBkt uint16, expiration ...time.Duration) *Cache[K] {
+	f := builtinPolicy[K](policy)
+	if f == nil {
+		v, ok := policies.Load(policyKey[K](policy))
+		if !ok {
+			panic("ecache2: unknown policy " + policy)
+		}
+		f = v.(func(cap uint32) Cacher[K])
+	}
+	c := newCacheShell[K](bucketCnt, expiration...)
+	for i := range c.insts {
+		c.insts[i][0] = f(uint32(capPerBkt))
 	}
 	return c
 }
 
+// tinyLFU wraps a classic LRU bucket with a frequency-sketch admission
+// filter: when the bucket is full, a new key is only admitted if it is
+// estimated to be accessed more often than the current LRU victim,
+// protecting the cache from one-hit-wonders scanning past it.
+type tinyLFU[K Hashable] struct {
+	*cache[K]
+	sketch []uint8 // 4-bit saturating counters, one per sketch slot
+	mask   int32
+	n      uint32 // accesses since the last halving, ages the sketch toward recent traffic
+}
+
+func newTinyLFU[K Hashable](capPerBkt uint32) *tinyLFU[K] {
+	w := maskOfNextPowOf2(uint16(capPerBkt))
+	if w < 255 {
+		w = 255
+	}
+	return &tinyLFU[K]{cache: create[K](capPerBkt, false), sketch: make([]uint8, w+1), mask: int32(w)}
+}
+
+// touch increments and returns k's estimated frequency, periodically
+// halving the whole sketch so stale counts decay over time.
+func (t *tinyLFU[K]) touch(k K) uint8 {
+	idx := hashKey(k, t.mask)
+	if t.sketch[idx] < 15 {
+		t.sketch[idx]++
+	}
+	if t.n++; t.n >= uint32(len(t.sketch))*10 {
+		for i := range t.sketch {
+			t.sketch[i] >>= 1
+		}
+		t.n = 0
+	}
+	return t.sketch[idx]
+}
+
+func (t *tinyLFU[K]) estimate(k K) uint8 { return t.sketch[hashKey(k, t.mask)] }
+
+// Put admits a new key only when there is room or its estimated access
+// frequency beats the current LRU victim's — the TinyLFU admission
+// check, layered in front of the embedded cache's own eviction path.
+func (t *tinyLFU[K]) Put(k K, i *interface{}, b []byte, expireAt int64, on Inspector[K]) int {
+	freq := t.touch(k)
+	if _, exists := t.hmap[k]; !exists && t.last == uint16(cap(t.m)) {
+		if victim := t.m[t.dlnk[0][p]-1]; freq <= t.estimate(victim.k) {
+			return 0 // rejected: admission filter favors the existing victim
+		}
+	}
+	return t.cache.Put(k, i, b, expireAt, on)
+}
+
+// Get implements Cacher, also counting the access for admission purposes.
+func (t *tinyLFU[K]) Get(k K, enforceTTL bool, refreshTo int64) (i *interface{}, b []byte, ok bool) {
+	t.touch(k)
+	return t.cache.Get(k, enforceTTL, refreshTo)
+}
+
 // LRU2 - add LRU-2 support (especially LRU-2 that when item visited twice it moves to upper-level-cache)
 // `capPerBkt` is length of each LRU-2 bucket, can store extra `capPerBkt * bucketCnt` count of items in Cache at most
 func (c *Cache[K]) LRU2(capPerBkt uint16) *Cache[K] {
 	for i := range c.insts {
-		c.insts[i][1] = create[K](uint32(capPerBkt))
+		c.insts[i][1] = create[K](uint32(capPerBkt), false)
 	}
 	return c
 }
@@ -216,10 +589,24 @@ func (c *Cache[K]) LRU2(capPerBkt uint16) *Cache[K] {
 // put - put a item into cache
 func (c *Cache[K]) put(key K, i *interface{}, b []byte) {
 	idx := hashKey(key, c.mask)
+	evicted := 0
+	onEvict := func(action int, k K, iface *interface{}, bytes []byte, status int) { // the victim's own notification, fired with status -1
+		if action == PUT && status == -1 {
+			evicted++ // byte-bounded buckets may evict several entries for one put
+		}
+		c.on(action, k, iface, bytes, status)
+	}
 	c.locks[idx].Lock()
-	status := c.insts[idx][0].put(key, i, b, now()+int64(c.expiration), c.on)
+	status := c.insts[idx][0].Put(key, i, b, now()+int64(c.expiration), onEvict)
 	c.locks[idx].Unlock()
 	c.on(PUT, key, i, b, status)
+	c.stats.recordPut(evicted)
+	if c.collector != nil {
+		c.collector.Put()
+		for ; evicted > 0; evicted-- {
+			c.collector.Evict()
+		}
+	}
 }
 
 // ToInt64 - convert bytes to int64
@@ -267,35 +654,77 @@ func (c *Cache[K]) GetInt64(key K) (int64, bool) {
 	return 0, false
 }
 
-func (c *Cache[K]) _get(key K, idx, level int32) (*node[K], int) {
-	if n, s := c.insts[idx][level].get(key); s > 0 && n.expireAt > 0 && (c.expiration <= 0 || now() < n.expireAt) {
-		n.expireAt = now() + int64(c.expiration) // refresh expiration
-		return n, s                              // no necessary to remove the expired item here, otherwise will cause GC thrashing
+func (c *Cache[K]) _peek(key K, idx, level int32) (i *interface{}, b []byte, ok bool) {
+	return c.insts[idx][level].Peek(key, c.expiration > 0)
+}
+
+// Peek - get value of key without refreshing its recency or firing the
+// GET inspector callback; useful for read-only inspection (e.g. the
+// hashicorp-compatible `lru` wrapper's Peek/Contains)
+func (c *Cache[K]) Peek(key K) (interface{}, bool) {
+	idx := hashKey(key, c.mask)
+	c.locks[idx].Lock()
+	i, _, ok := c._peek(key, idx, 0)
+	if !ok && c.insts[idx][1] != nil {
+		i, _, ok = c._peek(key, idx, 1)
 	}
-	return nil, 0
+	c.locks[idx].Unlock()
+	if !ok || i == nil {
+		return nil, false
+	}
+	return *i, true
+}
+
+func (c *Cache[K]) _get(key K, idx, level int32) (i *interface{}, b []byte, ok bool) {
+	// no need to remove the expired item here, otherwise will cause GC thrashing
+	return c.insts[idx][level].Get(key, c.expiration > 0, now()+int64(c.expiration))
 }
 
 func (c *Cache[K]) get(key K) (i *interface{}, b []byte, _ bool) {
 	idx := hashKey(key, c.mask)
 	c.locks[idx].Lock()
-	n, s := (*node[K])(nil), 0
+	ok, evicted := false, 0
 	if c.insts[idx][1] == nil { // (if LRU-2 mode not support, loss is little)
-		n, s = c._get(key, idx, 0) // normal lru mode
+		i, b, ok = c._get(key, idx, 0) // normal lru mode
 	} else { // LRU-2 mode
-		e := int64(0)
-		if n, s, e = c.insts[idx][0].del(key); s <= 0 {
-			n, s = c._get(key, idx, 1) // re-find in level-1
+		if pi, pb, e, s := c.insts[idx][0].Del(key); s {
+			c.insts[idx][1].Put(key, pi, pb, e, func(action int, k K, iface *interface{}, bytes []byte, status int) {
+				if action == PUT && status == -1 { // a level-1 victim displaced by this promotion
+					evicted++
+				}
+				c.on(action, k, iface, bytes, status)
+			}) // find in level-0, move to level-1
+			i, b, ok = pi, pb, true
 		} else {
-			c.insts[idx][1].put(key, n.v.i, n.v.b, e, c.on) // find in level-0, move to level-1
+			i, b, ok = c._get(key, idx, 1) // re-find in level-1
 		}
 	}
-	if s <= 0 {
-		c.locks[idx].Unlock()
+	expired := false
+	if !ok {
+		if ex, isExpirer := c.insts[idx][0].(expirer[K]); isExpirer {
+			expired = ex.Expired(key, now())
+		}
+	}
+	c.locks[idx].Unlock()
+	c.stats.recordGet(ok, expired)
+	c.stats.evictions.Add(uint64(evicted))
+	if c.collector != nil {
+		for ; evicted > 0; evicted-- {
+			c.collector.Evict()
+		}
+		if ok {
+			c.collector.Hit()
+		} else {
+			c.collector.Miss()
+			if expired {
+				c.collector.Expire()
+			}
+		}
+	}
+	if !ok {
 		c.on(GET, key, nil, nil, 0)
 		return
 	}
-	i, b = n.v.i, n.v.b
-	c.locks[idx].Unlock()
 	c.on(GET, key, i, b, 1)
 	return i, b, true
 }
@@ -304,34 +733,61 @@ func (c *Cache[K]) get(key K) (i *interface{}, b []byte, _ bool) {
 func (c *Cache[K]) Del(key K) {
 	idx := hashKey(key, c.mask)
 	c.locks[idx].Lock()
-	n, s, e := c.insts[idx][0].del(key)
+	i, b, e, s := c.insts[idx][0].Del(key)
 	if c.insts[idx][1] != nil { // (if LRU-2 mode not support, loss is little)
-		if n2, s2, e2 := c.insts[idx][1].del(key); n2 != nil && (n == nil || e < e2) { // callback latest added one if both exists
-			n, s = n2, s2
+		if i2, b2, e2, s2 := c.insts[idx][1].Del(key); s2 && (!s || e < e2) { // callback latest added one if both exists
+			i, b, s = i2, b2, s2
 		}
 	}
-	if s > 0 {
-		c.on(DEL, key, n.v.i, n.v.b, 1)
-		n.v.i, n.v.b = nil, nil // release now
+	if s {
+		c.on(DEL, key, i, b, 1)
 	} else {
 		c.on(DEL, key, nil, nil, 0)
 	}
 	c.locks[idx].Unlock()
 }
 
+// Len - current item count across all buckets (O(bucketCnt), each
+// bucket's own Len is O(1); no need to Walk)
+func (c *Cache[K]) Len() int {
+	n := 0
+	for i := range c.insts {
+		c.locks[i].Lock()
+		n += c.insts[i][0].Len()
+		if c.insts[i][1] != nil {
+			n += c.insts[i][1].Len()
+		}
+		c.locks[i].Unlock()
+	}
+	return n
+}
+
+// Cap - total item capacity across all buckets (approximate for
+// byte-bounded buckets)
+func (c *Cache[K]) Cap() int {
+	n := 0
+	for i := range c.insts {
+		n += c.insts[i][0].Cap()
+		if c.insts[i][1] != nil {
+			n += c.insts[i][1].Cap()
+		}
+	}
+	return n
+}
+
 // Walk - calls f sequentially for each valid item in the lru cache, return false to stop iteration for every bucket
 func (c *Cache[K]) Walk(walker func(key K, iface *interface{}, bytes []byte, expireAt int64) bool) {
 	for i := range c.insts {
 		c.locks[i].Lock()
-		if c.insts[i][0].walk(walker); c.insts[i][1] != nil {
-			c.insts[i][1].walk(walker)
+		if c.insts[i][0].Walk(walker); c.insts[i][1] != nil {
+			c.insts[i][1].Walk(walker)
 		}
 		c.locks[i].Unlock()
 	}
 }
 
 // Inspect - to inspect the actions
-func (c *Cache[K]) Inspect(insptr inspector[K]) {
+func (c *Cache[K]) Inspect(insptr Inspector[K]) {
 	old := c.on
 	c.on = func(action int, key K, iface *interface{}, bytes []byte, status int) {
 		old(action, key, iface, bytes, status) // call as the declared order, old first
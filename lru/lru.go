@@ -0,0 +1,129 @@
+// Package lru is a drop-in-compatible wrapper around ecache2.Cache that
+// mirrors the github.com/hashicorp/golang-lru/v2 API, for code migrating
+// off hashicorp's LRU without adopting ecache2's Put/PutBytes/PutInt64
+// split.
+package lru
+
+import (
+	"errors"
+
+	"github.com/orca-zhang/ecache2"
+)
+
+// Cache is a single, unsharded LRU bounded by size, matching
+// hashicorp/golang-lru/v2's Cache[K, V].
+type Cache[K ecache2.Hashable, V any] struct {
+	c   *ecache2.Cache[K]
+	cap int
+}
+
+// New - create an LRU cache with the given capacity
+func New[K ecache2.Hashable, V any](size int) (*Cache[K, V], error) {
+	if size <= 0 {
+		return nil, errors.New("must provide a positive size")
+	}
+	return &Cache[K, V]{c: ecache2.NewLRUCache[K](1, uint16(size)), cap: size}, nil
+}
+
+// NewWithEvict - create an LRU cache with the given capacity that calls
+// onEvicted for every entry evicted to make room for a new one
+func NewWithEvict[K ecache2.Hashable, V any](size int, onEvicted func(key K, value V)) (*Cache[K, V], error) {
+	c, err := New[K, V](size)
+	if err != nil {
+		return nil, err
+	}
+	if onEvicted != nil {
+		c.c.Inspect(func(action int, key K, iface *interface{}, bytes []byte, status int) {
+			if action != ecache2.PUT || status != -1 || iface == nil {
+				return
+			}
+			if v, ok := (*iface).(V); ok {
+				onEvicted(key, v)
+			}
+		})
+	}
+	return c, nil
+}
+
+// Add - adds a value to the cache, returning true if an existing entry
+// had to be evicted to make room for it (best-effort: true whenever the
+// cache was at capacity and key was not already present)
+func (c *Cache[K, V]) Add(key K, value V) (evicted bool) {
+	_, existed := c.c.Peek(key)
+	evicted = !existed && c.c.Len() >= c.cap
+	c.c.Put(key, value)
+	return evicted
+}
+
+// Get - looks up a key's value from the cache, refreshing its recency
+func (c *Cache[K, V]) Get(key K) (value V, ok bool) {
+	i, ok := c.c.Get(key)
+	if !ok {
+		return value, false
+	}
+	value, ok = i.(V)
+	return value, ok
+}
+
+// Contains - checks for the existence of key without refreshing its recency
+func (c *Cache[K, V]) Contains(key K) bool {
+	_, ok := c.c.Peek(key)
+	return ok
+}
+
+// Peek - returns the value associated with key without refreshing its recency
+func (c *Cache[K, V]) Peek(key K) (value V, ok bool) {
+	i, ok := c.c.Peek(key)
+	if !ok {
+		return value, false
+	}
+	value, ok = i.(V)
+	return value, ok
+}
+
+// Remove - removes the provided key, returning whether it existed
+func (c *Cache[K, V]) Remove(key K) bool {
+	_, ok := c.c.Peek(key)
+	c.c.Del(key)
+	return ok
+}
+
+// RemoveOldest - removes the least recently used entry
+func (c *Cache[K, V]) RemoveOldest() (key K, value V, ok bool) {
+	var i *interface{}
+	c.c.Walk(func(k K, iface *interface{}, bytes []byte, expireAt int64) bool {
+		key, i, ok = k, iface, true
+		return true // keep walking, the tail (oldest) is visited last
+	})
+	if !ok {
+		return key, value, false
+	}
+	c.c.Del(key)
+	if i != nil {
+		value, _ = (*i).(V)
+	}
+	return key, value, true
+}
+
+// Keys - returns a slice of the keys, oldest first
+func (c *Cache[K, V]) Keys() []K {
+	keys := make([]K, 0, c.cap)
+	c.c.Walk(func(k K, _ *interface{}, _ []byte, _ int64) bool { // Walk visits newest first
+		keys = append(keys, k)
+		return true
+	})
+	for l, r := 0, len(keys)-1; l < r; l, r = l+1, r-1 {
+		keys[l], keys[r] = keys[r], keys[l]
+	}
+	return keys
+}
+
+// Len - returns the number of items currently in the cache
+func (c *Cache[K, V]) Len() int { return c.c.Len() }
+
+// Purge - clears all cache entries
+func (c *Cache[K, V]) Purge() {
+	for _, k := range c.Keys() {
+		c.c.Del(k)
+	}
+}
@@ -0,0 +1,96 @@
+package ecache2
+
+import (
+	"math"
+	"sync/atomic"
+)
+
+// MetricsCollector receives cache events as they happen, for plugging in
+// a Prometheus/OpenTelemetry adapter alongside the built-in lock-free
+// counters that back Stats(); install one via Cache.SetMetricsCollector.
+type MetricsCollector interface {
+	Hit()
+	Miss()
+	Put()
+	Evict()
+	Expire()
+}
+
+// hitRateDecay is the EWMA weight given to each Get's outcome, biasing
+// Stats().HitRate toward recent traffic rather than the cache's lifetime.
+const hitRateDecay = 0.1
+
+// metrics is the built-in, lock-free counter set behind Stats(): plain
+// atomic.Uint64 counters plus an EWMA hit rate, updated from the
+// get/put hot paths without ever taking a metrics-specific mutex.
+type metrics struct {
+	hits, misses, puts, evictions, expirations atomic.Uint64
+	hitRateBits                                atomic.Uint64 // math.Float64bits of the EWMA hit rate
+}
+
+func (m *metrics) recordPut(evicted int) {
+	m.puts.Add(1)
+	m.evictions.Add(uint64(evicted))
+}
+
+func (m *metrics) recordGet(hit, expired bool) {
+	sample := 0.0
+	if hit {
+		m.hits.Add(1)
+		sample = 1.0
+	} else {
+		m.misses.Add(1)
+		if expired {
+			m.expirations.Add(1)
+		}
+	}
+	for {
+		old := m.hitRateBits.Load()
+		next := math.Float64bits(math.Float64frombits(old) + hitRateDecay*(sample-math.Float64frombits(old)))
+		if m.hitRateBits.CompareAndSwap(old, next) {
+			return
+		}
+	}
+}
+
+func (m *metrics) hitRate() float64 { return math.Float64frombits(m.hitRateBits.Load()) }
+
+// Stats is a point-in-time snapshot of a Cache[K]'s counters, returned by Stats().
+type Stats struct {
+	Hits, Misses, Puts, Evictions, Expirations uint64
+	BucketSizes                                []int   // current item count, one entry per bucket
+	HitRate                                    float64 // EWMA of recent Get outcomes, in [0, 1]
+}
+
+// SetMetricsCollector installs an optional external sink that mirrors
+// every hit/miss/put/eviction/expiration alongside Cache[K]'s own
+// counters (Stats keeps working regardless of what's installed here, or
+// whether anything is). Returns the receiver so calls can be chained.
+func (c *Cache[K]) SetMetricsCollector(m MetricsCollector) *Cache[K] {
+	c.collector = m
+	return c
+}
+
+// Stats snapshots hit/miss/put/eviction/expiration counters (updated
+// atomically from the hot paths, no bucket mutex involved) plus each
+// bucket's current item count and a moving-average hit rate.
+func (c *Cache[K]) Stats() Stats {
+	sizes := make([]int, len(c.insts))
+	for i := range c.insts {
+		c.locks[i].Lock()
+		sizes[i] = c.insts[i][0].Len()
+		if c.insts[i][1] != nil {
+			sizes[i] += c.insts[i][1].Len()
+		}
+		c.locks[i].Unlock()
+	}
+	return Stats{
+		Hits:        c.stats.hits.Load(),
+		Misses:      c.stats.misses.Load(),
+		Puts:        c.stats.puts.Load(),
+		Evictions:   c.stats.evictions.Load(),
+		Expirations: c.stats.expirations.Load(),
+		BucketSizes: sizes,
+		HitRate:     c.stats.hitRate(),
+	}
+}
@@ -0,0 +1,68 @@
+// Package redigoadapter adapts a github.com/gomodule/redigo connection
+// pool to ecache2.RedisClient, for use as a Tiered cache's L2.
+package redigoadapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/orca-zhang/ecache2"
+)
+
+// Client adapts a *redis.Pool (redigo) to ecache2.RedisClient.
+type Client struct{ pool *redis.Pool }
+
+// New wraps an existing redigo connection pool.
+func New(pool *redis.Pool) *Client { return &Client{pool: pool} }
+
+// Get returns ecache2.ErrCacheMiss when key is absent.
+func (c *Client) Get(ctx context.Context, key string) ([]byte, error) {
+	conn, err := c.pool.GetContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	b, err := redis.Bytes(conn.Do("GET", key))
+	if err == redis.ErrNil {
+		return nil, ecache2.ErrCacheMiss
+	}
+	return b, err
+}
+
+// Set stores val under key with the given TTL (0 means no expiration).
+func (c *Client) Set(ctx context.Context, key string, val []byte, ttl time.Duration) error {
+	conn, err := c.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if ttl > 0 {
+		_, err = conn.Do("SET", key, val, "PX", ttl.Milliseconds())
+	} else {
+		_, err = conn.Do("SET", key, val)
+	}
+	return err
+}
+
+// Del removes key.
+func (c *Client) Del(ctx context.Context, key string) error {
+	conn, err := c.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = conn.Do("DEL", key)
+	return err
+}
+
+// Expire resets key's TTL.
+func (c *Client) Expire(ctx context.Context, key string, ttl time.Duration) error {
+	conn, err := c.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = conn.Do("PEXPIRE", key, ttl.Milliseconds())
+	return err
+}
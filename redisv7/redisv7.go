@@ -0,0 +1,41 @@
+// Package redisv7 adapts a github.com/go-redis/redis/v7 client to
+// ecache2.RedisClient, for use as a Tiered cache's L2.
+package redisv7
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"github.com/orca-zhang/ecache2"
+)
+
+// Client adapts a *redis.Client (go-redis v7) to ecache2.RedisClient.
+type Client struct{ c *redis.Client }
+
+// New wraps an existing go-redis v7 client.
+func New(c *redis.Client) *Client { return &Client{c: c} }
+
+// Get returns ecache2.ErrCacheMiss when key is absent.
+func (c *Client) Get(ctx context.Context, key string) ([]byte, error) {
+	b, err := c.c.WithContext(ctx).Get(key).Bytes()
+	if err == redis.Nil {
+		return nil, ecache2.ErrCacheMiss
+	}
+	return b, err
+}
+
+// Set stores val under key with the given TTL (0 means no expiration).
+func (c *Client) Set(ctx context.Context, key string, val []byte, ttl time.Duration) error {
+	return c.c.WithContext(ctx).Set(key, val, ttl).Err()
+}
+
+// Del removes key.
+func (c *Client) Del(ctx context.Context, key string) error {
+	return c.c.WithContext(ctx).Del(key).Err()
+}
+
+// Expire resets key's TTL.
+func (c *Client) Expire(ctx context.Context, key string, ttl time.Duration) error {
+	return c.c.WithContext(ctx).Expire(key, ttl).Err()
+}
@@ -0,0 +1,166 @@
+package ecache2
+
+import (
+	"strings"
+	"sync"
+)
+
+// StructCache adds secondary indexing on top of Cache[string]: register one
+// or more named lookup keys extracted from T, `Put` inserts the object under
+// every declared index sharing the same backing value, and `GetBy` fetches
+// it through any of them. Deleting (explicitly, or via capacity eviction in
+// any one index) purges the object from every other index too.
+type StructCache[T any] struct {
+	bucketCnt, capPerBkt uint16
+	indices              []string
+	keyFns               map[string]func(T) string
+	caches               map[string]*Cache[string]
+	mu                   sync.Mutex      // guards rev, touched on every Put/Del/eviction
+	rev                  map[*T][]string // object -> ["indexName:key", ...], to purge siblings on eviction
+}
+
+// NewStructCache - create a struct cache; parameters are forwarded to each
+// index's underlying Cache[string] (see NewLRUCache)
+func NewStructCache[T any](bucketCnt, capPerBkt uint16) *StructCache[T] {
+	return &StructCache[T]{
+		bucketCnt: bucketCnt,
+		capPerBkt: capPerBkt,
+		keyFns:    map[string]func(T) string{},
+		caches:    map[string]*Cache[string]{},
+		rev:       map[*T][]string{},
+	}
+}
+
+// Index registers a named lookup key extracted from T via keyFn, returning
+// the receiver so calls can be chained
+func (s *StructCache[T]) Index(name string, keyFn func(T) string) *StructCache[T] {
+	c := NewLRUCache[string](s.bucketCnt, s.capPerBkt)
+	c.Inspect(func(action int, _ string, iface *interface{}, _ []byte, status int) {
+		if action != PUT || status != -1 || iface == nil { // only capacity evictions ("PUT" with status -1) need propagating
+			return
+		}
+		if obj, ok := (*iface).(*T); ok {
+			s.purge(obj, name)
+		}
+	})
+	s.keyFns[name] = keyFn
+	s.caches[name] = c
+	s.indices = append(s.indices, name)
+	return s
+}
+
+// Put inserts v under every declared index, all sharing one boxed copy.
+// Re-Putting overwrites each Cache[string] in place (no eviction, so the
+// purge-on-evict Inspect hook never fires), and if an indexed field's
+// value itself changed, the new key doesn't even alias the old entry.
+// So Put looks up the previous object through any index whose key is
+// unchanged, diffs its full key set (from rev) against the new one, and
+// removes whichever old entries no longer match.
+func (s *StructCache[T]) Put(v T) {
+	obj := &v
+	newKey := make(map[string]string, len(s.indices))
+	keys := make([]string, 0, len(s.indices))
+	for _, name := range s.indices {
+		k := s.keyFns[name](v)
+		newKey[name] = k
+		keys = append(keys, name+":"+k)
+	}
+	newKeySet := make(map[string]struct{}, len(keys))
+	for _, ik := range keys {
+		newKeySet[ik] = struct{}{}
+	}
+
+	olds := map[*T][]string{}
+	for _, name := range s.indices {
+		i, ok := s.caches[name].Peek(newKey[name])
+		if !ok {
+			continue
+		}
+		p, ok := i.(*T)
+		if !ok || p == obj {
+			continue
+		}
+		if _, seen := olds[p]; !seen {
+			s.mu.Lock()
+			olds[p] = s.rev[p]
+			s.mu.Unlock()
+		}
+	}
+
+	for _, name := range s.indices {
+		s.caches[name].Put(newKey[name], obj)
+	}
+
+	for _, oldKeys := range olds {
+		for _, ik := range oldKeys {
+			if _, stillCurrent := newKeySet[ik]; stillCurrent {
+				continue
+			}
+			name, key, _ := strings.Cut(ik, ":")
+			s.caches[name].Del(key)
+		}
+	}
+
+	s.mu.Lock()
+	for p := range olds {
+		delete(s.rev, p)
+	}
+	s.rev[obj] = keys
+	s.mu.Unlock()
+}
+
+// GetBy fetches the object last put under key in the named index
+func (s *StructCache[T]) GetBy(index, key string) (v T, ok bool) {
+	c, ok := s.caches[index]
+	if !ok {
+		return v, false
+	}
+	i, ok := c.Get(key)
+	if !ok {
+		return v, false
+	}
+	obj, ok := i.(*T)
+	if !ok {
+		return v, false
+	}
+	return *obj, true
+}
+
+// Del removes the object found under key in the named index, purging it
+// from every other declared index as well
+func (s *StructCache[T]) Del(index, key string) {
+	c, ok := s.caches[index]
+	if !ok {
+		return
+	}
+	i, ok := c.Peek(key)
+	if !ok {
+		return
+	}
+	obj, ok := i.(*T)
+	if !ok {
+		return
+	}
+	s.purge(obj, "")
+}
+
+// purge drops obj from every index it was stored under except
+// skipIndex (already removed there, by the caller or by eviction)
+func (s *StructCache[T]) purge(obj *T, skipIndex string) {
+	s.mu.Lock()
+	keys, ok := s.rev[obj]
+	delete(s.rev, obj)
+	s.mu.Unlock()
+	if !ok {
+		return
+	}
+	for _, ik := range keys {
+		name, key, _ := strings.Cut(ik, ":")
+		if name == skipIndex {
+			continue
+		}
+		if c, ok := s.caches[name]; ok {
+			c.Del(key)
+		}
+	}
+}
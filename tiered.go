@@ -0,0 +1,216 @@
+package ecache2
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// ErrCacheMiss is returned by a RedisClient.Get when key is not present in L2.
+var ErrCacheMiss = errors.New("ecache2: cache miss")
+
+// RedisClient is the minimal L2 surface Tiered needs, small enough to be
+// implemented over go-redis v7, go-redis v8, or redigo without pulling any
+// of them into this package.
+type RedisClient interface {
+	Get(ctx context.Context, key string) ([]byte, error) // ErrCacheMiss if absent
+	Set(ctx context.Context, key string, val []byte, ttl time.Duration) error
+	Del(ctx context.Context, key string) error
+	Expire(ctx context.Context, key string, ttl time.Duration) error
+}
+
+// Loader fetches the value for key on a miss in both L1 and L2; a nil error
+// with ok=false records a negative (not-found) result.
+type Loader[K Hashable] func(ctx context.Context, key K) (val interface{}, ok bool, err error)
+
+// Codec (de)serializes values for the L2 Redis backend.
+type Codec interface {
+	Encode(v interface{}) ([]byte, error)
+	Decode(b []byte) (interface{}, error)
+}
+
+// defaultCodec stores []byte and int64 raw (matching PutBytes/PutInt64's
+// own format) and falls back to gob for everything else; a one-byte tag
+// disambiguates the three on decode.
+type defaultCodec struct{}
+
+const (
+	tagBytes byte = iota
+	tagInt64
+	tagGob
+)
+
+func (defaultCodec) Encode(v interface{}) ([]byte, error) {
+	switch t := v.(type) {
+	case []byte:
+		return append([]byte{tagBytes}, t...), nil
+	case int64:
+		buf := make([]byte, 9)
+		buf[0] = tagInt64
+		binary.LittleEndian.PutUint64(buf[1:], uint64(t))
+		return buf, nil
+	default:
+		var buf bytes.Buffer
+		buf.WriteByte(tagGob)
+		if err := gob.NewEncoder(&buf).Encode(&v); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+}
+
+func (defaultCodec) Decode(b []byte) (interface{}, error) {
+	if len(b) == 0 {
+		return nil, errors.New("ecache2: empty payload")
+	}
+	switch b[0] {
+	case tagBytes:
+		return b[1:], nil
+	case tagInt64:
+		if len(b) < 9 {
+			return nil, errors.New("ecache2: truncated int64 payload")
+		}
+		return int64(binary.LittleEndian.Uint64(b[1:9])), nil
+	case tagGob:
+		var v interface{}
+		if err := gob.NewDecoder(bytes.NewReader(b[1:])).Decode(&v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	default:
+		return nil, fmt.Errorf("ecache2: unknown payload tag %d", b[0])
+	}
+}
+
+// negMarker is a reserved, tag-free L2 payload standing in for a cached
+// not-found result; it can't collide with defaultCodec's tagged output.
+var negMarker = []byte{0xFF}
+
+// loadResult is the singleflight payload: val/ok are kept apart rather
+// than overloading a bare interface{} return, so a loader legitimately
+// caching a nil value isn't mistaken for "not found".
+type loadResult struct {
+	val interface{}
+	ok  bool
+}
+
+// Tiered composes an in-process Cache[K] (L1) with a Redis-backed L2: a
+// miss in both coalesces concurrent loads for the same key via
+// singleflight, so a cold key produces one backend round-trip rather than
+// N, and not-found results are cached too (with a separate, shorter TTL)
+// to protect the loader from lookup storms on nonexistent keys.
+type Tiered[K Hashable] struct {
+	l1        *Cache[K]
+	l1Neg     *Cache[K]
+	l2        RedisClient
+	codec     Codec
+	load      Loader[K]
+	ttl       time.Duration
+	negTTL    time.Duration
+	keyPrefix string
+	sf        singleflight.Group
+}
+
+// NewTiered - create a tiered cache; bucketCnt/capPerBkt size L1 (see
+// NewLRUCache), ttl is the normal entry lifetime for L1 and L2, negTTL is
+// the (shorter) lifetime for cached not-found results, and keyPrefix
+// namespaces L2 keys so multiple Tiered caches can share one Redis
+// instance
+func NewTiered[K Hashable](bucketCnt, capPerBkt uint16, l2 RedisClient, load Loader[K], ttl, negTTL time.Duration, keyPrefix string) *Tiered[K] {
+	return &Tiered[K]{
+		l1:        NewLRUCache[K](bucketCnt, capPerBkt, ttl),
+		l1Neg:     NewLRUCache[K](bucketCnt, capPerBkt, negTTL),
+		l2:        l2,
+		codec:     defaultCodec{},
+		load:      load,
+		ttl:       ttl,
+		negTTL:    negTTL,
+		keyPrefix: keyPrefix,
+	}
+}
+
+// WithCodec overrides the codec used to (de)serialize values for L2,
+// returning the receiver so calls can be chained
+func (t *Tiered[K]) WithCodec(c Codec) *Tiered[K] {
+	t.codec = c
+	return t
+}
+
+func (t *Tiered[K]) redisKey(key K) string { return t.keyPrefix + fmt.Sprint(key) }
+
+// Get fetches the value of key, checking L1, then L2, then the loader;
+// hits are promoted upward (L2 -> L1) and concurrent misses for the same
+// key are coalesced into a single loader call
+func (t *Tiered[K]) Get(ctx context.Context, key K) (interface{}, bool, error) {
+	if v, ok := t.l1.Get(key); ok {
+		return v, true, nil
+	}
+	if _, ok := t.l1Neg.Get(key); ok {
+		return nil, false, nil
+	}
+
+	rk := t.redisKey(key)
+	b, err := t.l2.Get(ctx, rk)
+	switch {
+	case err == nil:
+		if bytes.Equal(b, negMarker) {
+			t.l1Neg.Put(key, struct{}{})
+			return nil, false, nil
+		}
+		if v, derr := t.codec.Decode(b); derr == nil {
+			t.l1.Put(key, v)
+			if t.ttl > 0 { // ttl 0 stores without expiration, and expiring by 0 would remove the key
+				_ = t.l2.Expire(ctx, rk, t.ttl) // promotion counts as a touch: slide L2's TTL forward too
+			}
+			return v, true, nil
+		}
+	case !errors.Is(err, ErrCacheMiss):
+		return nil, false, err
+	}
+
+	r, err, _ := t.sf.Do(rk, func() (interface{}, error) {
+		val, ok, lerr := t.load(ctx, key)
+		if lerr != nil {
+			return nil, lerr
+		}
+		if !ok {
+			t.l1Neg.Put(key, struct{}{})
+			_ = t.l2.Set(ctx, rk, negMarker, t.negTTL)
+			return loadResult{nil, false}, nil
+		}
+		t.l1.Put(key, val)
+		if enc, eerr := t.codec.Encode(val); eerr == nil {
+			_ = t.l2.Set(ctx, rk, enc, t.ttl)
+		}
+		return loadResult{val, true}, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	res := r.(loadResult)
+	return res.val, res.ok, nil
+}
+
+// Put inserts key into L1 and L2, bypassing the loader
+func (t *Tiered[K]) Put(ctx context.Context, key K, val interface{}) error {
+	t.l1.Put(key, val)
+	t.l1Neg.Del(key)
+	enc, err := t.codec.Encode(val)
+	if err != nil {
+		return err
+	}
+	return t.l2.Set(ctx, t.redisKey(key), enc, t.ttl)
+}
+
+// Del removes key from L1 and L2
+func (t *Tiered[K]) Del(ctx context.Context, key K) error {
+	t.l1.Del(key)
+	t.l1Neg.Del(key)
+	return t.l2.Del(ctx, t.redisKey(key))
+}